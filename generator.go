@@ -0,0 +1,155 @@
+package nanoid
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+)
+
+// Generator produces Nano IDs repeatedly while reusing its mask/step
+// calculation and scratch buffers, avoiding the per-call overhead that
+// FormatString pays for one-off generation. Like GenerateString, it uses
+// FormatStringUnbiased's draw instead of the mask-rejection approach when
+// len(alphabet) is not a power of two, so the two code paths stay
+// correctness-equivalent. A Generator is not safe for concurrent use by
+// multiple goroutines.
+type Generator struct {
+	alphabet  string
+	size      int
+	mask      int
+	step      int
+	unbiased  bool
+	threshold uint32
+	read      func(p []byte) error
+	randBuf   []byte
+	word      []byte
+	out       []byte
+}
+
+// Option configures a Generator created by NewGenerator.
+type Option func(*Generator)
+
+// WithReader replaces the Generator's entropy source with r, read via
+// io.ReadFull. This lets tests inject deterministic randomness instead of
+// crypto/rand.
+func WithReader(r io.Reader) Option {
+	return func(g *Generator) {
+		g.read = func(p []byte) error {
+			_, err := io.ReadFull(r, p)
+			return err
+		}
+	}
+}
+
+// NewGenerator creates a Generator that produces IDs of size characters
+// drawn from alphabet. The mask and step used to reject biased bytes are
+// computed once, and the Generator's scratch buffers are reused on every
+// call to Next, NextInto, and NextN.
+func NewGenerator(alphabet string, size int, opts ...Option) *Generator {
+	g := &Generator{
+		alphabet: alphabet,
+		size:     size,
+		read:     func(p []byte) error { _, err := rand.Read(p); return err },
+		out:      make([]byte, size),
+	}
+
+	if isPowerOfTwo(len(alphabet)) {
+		g.mask = 2<<uint32(31-bits.LeadingZeros32(uint32(len(alphabet)-1|1))) - 1
+		g.step = int(math.Ceil(1.6 * float64(g.mask*size) / float64(len(alphabet))))
+		g.randBuf = make([]byte, g.step)
+	} else {
+		n := uint32(len(alphabet))
+		g.unbiased = true
+		g.threshold = -n % n
+		g.word = make([]byte, 4)
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// fill draws random bytes into the Generator's scratch buffers until dst is
+// full, using whichever of the two draw methods was selected in
+// NewGenerator based on len(alphabet).
+func (g *Generator) fill(dst []byte) error {
+	if g.unbiased {
+		return g.fillUnbiased(dst)
+	}
+	return g.fillMasked(dst)
+}
+
+// fillMasked rejects bytes that would bias the result as FormatString does,
+// for alphabets whose length is a power of two.
+func (g *Generator) fillMasked(dst []byte) error {
+	n := 0
+	for n < len(dst) {
+		if err := g.read(g.randBuf); err != nil {
+			return err
+		}
+		for i := 0; i < g.step && n < len(dst); i++ {
+			currentIndex := int(g.randBuf[i]) & g.mask
+			if currentIndex < len(g.alphabet) {
+				dst[n] = g.alphabet[currentIndex]
+				n++
+			}
+		}
+	}
+	return nil
+}
+
+// fillUnbiased draws characters using the same Lemire unbiased
+// bounded-modulo method as FormatStringUnbiased, for alphabets whose
+// length is not a power of two.
+func (g *Generator) fillUnbiased(dst []byte) error {
+	n := uint32(len(g.alphabet))
+	for i := 0; i < len(dst); {
+		if err := g.read(g.word); err != nil {
+			return err
+		}
+
+		v := binary.BigEndian.Uint32(g.word)
+		prod := uint64(v) * uint64(n)
+		if uint32(prod) < g.threshold {
+			continue
+		}
+		dst[i] = g.alphabet[uint32(prod>>32)]
+		i++
+	}
+	return nil
+}
+
+// Next generates a new ID, reusing the Generator's scratch buffers. The
+// returned string is a fresh copy safe to retain.
+func (g *Generator) Next() (string, error) {
+	if err := g.fill(g.out); err != nil {
+		return "", err
+	}
+	return string(g.out), nil
+}
+
+// NextInto writes a new ID into dst, which must have length >= the
+// Generator's size. It performs no allocation of its own.
+func (g *Generator) NextInto(dst []byte) error {
+	if len(dst) < g.size {
+		return fmt.Errorf("nanoid: dst has length %d, want at least %d", len(dst), g.size)
+	}
+	return g.fill(dst[:g.size])
+}
+
+// NextN generates n IDs in a single call.
+func (g *Generator) NextN(n int) ([]string, error) {
+	ids := make([]string, n)
+	for i := range ids {
+		id, err := g.Next()
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
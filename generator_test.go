@@ -0,0 +1,124 @@
+package nanoid
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestGeneratorNext(t *testing.T) {
+	g := NewGenerator(DefaultAlphabet, DefaultSize)
+
+	seen := make(map[string]bool)
+	for i := 0; i < 1000; i++ {
+		id, err := g.Next()
+		if err != nil {
+			t.Fatalf("Next() returned error: %v", err)
+		}
+		if len(id) != DefaultSize {
+			t.Fatalf("Next() = %q, want length %d", id, DefaultSize)
+		}
+		if seen[id] {
+			t.Fatalf("Next() produced duplicate id %q", id)
+		}
+		seen[id] = true
+	}
+}
+
+func TestGeneratorNextInto(t *testing.T) {
+	g := NewGenerator(DefaultAlphabet, DefaultSize)
+
+	dst := make([]byte, DefaultSize)
+	if err := g.NextInto(dst); err != nil {
+		t.Fatalf("NextInto() returned error: %v", err)
+	}
+	if len(dst) != DefaultSize {
+		t.Fatalf("NextInto() wrote %d bytes, want %d", len(dst), DefaultSize)
+	}
+
+	if err := g.NextInto(make([]byte, DefaultSize-1)); err == nil {
+		t.Fatal("NextInto() with undersized dst should return an error")
+	}
+}
+
+func TestGeneratorNextN(t *testing.T) {
+	g := NewGenerator(DefaultAlphabet, DefaultSize)
+
+	ids, err := g.NextN(10)
+	if err != nil {
+		t.Fatalf("NextN() returned error: %v", err)
+	}
+	if len(ids) != 10 {
+		t.Fatalf("NextN(10) returned %d ids, want 10", len(ids))
+	}
+	for _, id := range ids {
+		if len(id) != DefaultSize {
+			t.Fatalf("NextN() produced id %q with wrong length", id)
+		}
+	}
+}
+
+func TestGeneratorWithReader(t *testing.T) {
+	// A repeating source of zero bytes always selects alphabet[0]. Uses
+	// DefaultAlphabet (a power-of-two size) so this exercises the masked
+	// draw path; an all-zero stream would starve the unbiased path's
+	// rejection sampling.
+	r := bytes.NewReader(bytes.Repeat([]byte{0}, 1<<16))
+	g := NewGenerator(DefaultAlphabet, 8, WithReader(r))
+
+	id, err := g.Next()
+	if err != nil {
+		t.Fatalf("Next() returned error: %v", err)
+	}
+	if strings.Count(id, string(DefaultAlphabet[0])) != len(id) {
+		t.Fatalf("Next() = %q, want all %q", id, string(DefaultAlphabet[0]))
+	}
+}
+
+func TestGeneratorNonPowerOfTwoAlphabet(t *testing.T) {
+	// AlphaOnly has 52 characters, not a power of two, so this exercises
+	// Generator's unbiased draw path with real crypto randomness.
+	g := NewGenerator(AlphaOnly, 32)
+
+	id, err := g.Next()
+	if err != nil {
+		t.Fatalf("Next() returned error: %v", err)
+	}
+	if len(id) != 32 {
+		t.Fatalf("Next() = %q, want length 32", id)
+	}
+	for _, r := range id {
+		if !strings.ContainsRune(AlphaOnly, r) {
+			t.Fatalf("Next() produced %q, not in alphabet %q", r, AlphaOnly)
+		}
+	}
+}
+
+func BenchmarkFormatString(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := FormatString(generateRandomBuffer, DefaultAlphabet, DefaultSize); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGeneratorNext(b *testing.B) {
+	g := NewGenerator(DefaultAlphabet, DefaultSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := g.Next(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkGeneratorNextInto(b *testing.B) {
+	g := NewGenerator(DefaultAlphabet, DefaultSize)
+	dst := make([]byte, DefaultSize)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := g.NextInto(dst); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
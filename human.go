@@ -0,0 +1,53 @@
+package nanoid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Human is an alphabet of unambiguous, easily spoken characters. It drops
+// characters that are commonly confused with one another when handwritten,
+// printed, or read aloud: 0/O, 1/l/I.
+const Human = "23456789abcdefghjkmnpqrstuvwxyz"
+
+// NewHuman generates a random string of size characters drawn from the
+// Human alphabet.
+func NewHuman(size int) (string, error) {
+	return GenerateString(Human, size)
+}
+
+// NewReadable generates a random Human-alphabet string of size characters
+// and groups it into dash-separated 4-character blocks, like WebSafeID,
+// making it easier for a person to type or read aloud (e.g. license keys,
+// recovery codes).
+func NewReadable(size int) (string, error) {
+	id, err := NewHuman(size)
+	if err != nil {
+		return "", err
+	}
+	return groupBy4(id), nil
+}
+
+// groupBy4 splits s into dash-separated 4-character blocks.
+func groupBy4(s string) string {
+	var groups []string
+	for len(s) > 4 {
+		groups = append(groups, s[:4])
+		s = s[4:]
+	}
+	groups = append(groups, s)
+	return strings.Join(groups, "-")
+}
+
+// ParseReadable strips the grouping dashes added by NewReadable and
+// validates that every remaining character belongs to the Human alphabet,
+// returning the ungrouped id.
+func ParseReadable(s string) (string, error) {
+	id := strings.ReplaceAll(s, "-", "")
+	for _, r := range id {
+		if !strings.ContainsRune(Human, r) {
+			return "", fmt.Errorf("nanoid: %q is not a valid Human alphabet character", r)
+		}
+	}
+	return id, nil
+}
@@ -0,0 +1,37 @@
+package nanoid
+
+import "testing"
+
+func TestNewHuman(t *testing.T) {
+	id, err := NewHuman(16)
+	if err != nil {
+		t.Fatalf("NewHuman() returned error: %v", err)
+	}
+	if len(id) != 16 {
+		t.Fatalf("NewHuman(16) = %q, want length 16", id)
+	}
+	for _, r := range id {
+		if r == '0' || r == 'O' || r == '1' || r == 'l' || r == 'I' {
+			t.Fatalf("NewHuman() produced ambiguous character %q", r)
+		}
+	}
+}
+
+func TestNewReadableAndParseReadable(t *testing.T) {
+	id, err := NewReadable(16)
+	if err != nil {
+		t.Fatalf("NewReadable() returned error: %v", err)
+	}
+
+	parsed, err := ParseReadable(id)
+	if err != nil {
+		t.Fatalf("ParseReadable(%q) returned error: %v", id, err)
+	}
+	if len(parsed) != 16 {
+		t.Fatalf("ParseReadable(%q) = %q, want length 16", id, parsed)
+	}
+
+	if _, err := ParseReadable("abcd-####"); err == nil {
+		t.Fatal("ParseReadable() should reject characters outside the Human alphabet")
+	}
+}
@@ -72,8 +72,15 @@ func FormatString(generateRandomBuffer BytesGenerator, alphabet string, size int
 	}
 }
 
-// GenerateString generates a random string based on alphabet and size.
+// GenerateString generates a random string based on alphabet and size. When
+// len(alphabet) is not a power of two, it uses FormatStringUnbiased instead
+// of FormatString, since the mask-rejection approach wastes entropy and
+// redraws more often on alphabets like the 31-character Human set.
 func GenerateString(alphabet string, size int) (string, error) {
+	if !isPowerOfTwo(len(alphabet)) {
+		return FormatStringUnbiased(generateRandomBuffer, alphabet, size)
+	}
+
 	id, err := FormatString(generateRandomBuffer, alphabet, size)
 	if err != nil {
 		return "", err
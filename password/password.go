@@ -0,0 +1,127 @@
+// Package password generates random passwords built on top of nanoid's
+// GenerateString, guaranteeing minimum counts of digits and symbols rather
+// than drawing uniformly from one combined alphabet.
+package password
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/BartAlcorn/nanoid"
+)
+
+const (
+	lowerAlphabet  = "abcdefghijklmnopqrstuvwxyz"
+	upperAlphabet  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitAlphabet  = "0123456789"
+	symbolAlphabet = "!@#$%^&*()_+-=[]{}|;:,.<>?"
+)
+
+// Generate creates a random password of the given length containing at
+// least numDigits digits and numSymbols symbols, with the remaining
+// characters drawn from lowercase letters (and uppercase, when allowUpper
+// is true). When allowRepeat is false, no character appears more than once
+// in the result.
+func Generate(length, numDigits, numSymbols int, allowUpper, allowRepeat bool) (string, error) {
+	if numDigits < 0 || numSymbols < 0 || numDigits+numSymbols > length {
+		return "", fmt.Errorf("password: numDigits (%d) + numSymbols (%d) must not exceed length (%d)", numDigits, numSymbols, length)
+	}
+
+	letters := lowerAlphabet
+	if allowUpper {
+		letters += upperAlphabet
+	}
+	numLetters := length - numDigits - numSymbols
+
+	pools := []struct {
+		alphabet string
+		n        int
+	}{
+		{letters, numLetters},
+		{digitAlphabet, numDigits},
+		{symbolAlphabet, numSymbols},
+	}
+
+	if !allowRepeat {
+		for _, pool := range pools {
+			if pool.n > len(pool.alphabet) {
+				return "", fmt.Errorf("password: requested %d characters from a %d-character pool with allowRepeat=false", pool.n, len(pool.alphabet))
+			}
+		}
+	}
+
+	used := make(map[byte]bool)
+	pw := make([]byte, 0, length)
+
+	for _, pool := range pools {
+		drawn, err := draw(pool.alphabet, pool.n, allowRepeat, used)
+		if err != nil {
+			return "", err
+		}
+		pw = append(pw, drawn...)
+	}
+
+	if err := shuffle(pw); err != nil {
+		return "", err
+	}
+	return string(pw), nil
+}
+
+// MustGenerate is like Generate but does not return an error.
+func MustGenerate(length, numDigits, numSymbols int, allowUpper, allowRepeat bool) string {
+	pw, err := Generate(length, numDigits, numSymbols, allowUpper, allowRepeat)
+	if err != nil {
+		fmt.Printf("%v %v\n", "ERROR creating password", err)
+	}
+	return pw
+}
+
+// Entropy returns the bits of entropy of pw, assuming its characters were
+// drawn uniformly from alphabet.
+func Entropy(pw string, alphabet string) float64 {
+	return float64(len(pw)) * math.Log2(float64(len(alphabet)))
+}
+
+// draw generates n characters from alphabet, tracking characters already
+// used across pools in used so that allowRepeat=false can be enforced on
+// the password as a whole.
+func draw(alphabet string, n int, allowRepeat bool, used map[byte]bool) ([]byte, error) {
+	out := make([]byte, 0, n)
+	for len(out) < n {
+		c, err := nanoid.GenerateString(alphabet, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		ch := c[0]
+		if !allowRepeat && used[ch] {
+			continue
+		}
+		used[ch] = true
+		out = append(out, ch)
+	}
+	return out, nil
+}
+
+// shuffle performs a cryptographically random Fisher-Yates shuffle of b.
+func shuffle(b []byte) error {
+	for i := len(b) - 1; i > 0; i-- {
+		j, err := randIndex(i + 1)
+		if err != nil {
+			return err
+		}
+		b[i], b[j] = b[j], b[i]
+	}
+	return nil
+}
+
+// randIndex returns a cryptographically random integer in [0, n).
+func randIndex(n int) (int, error) {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(v.Int64()), nil
+}
@@ -0,0 +1,79 @@
+package password
+
+import (
+	"strings"
+	"testing"
+)
+
+func countAny(s, chars string) int {
+	n := 0
+	for _, r := range s {
+		if strings.ContainsRune(chars, r) {
+			n++
+		}
+	}
+	return n
+}
+
+func TestGenerateLengthAndClassCounts(t *testing.T) {
+	pw, err := Generate(20, 4, 3, true, true)
+	if err != nil {
+		t.Fatalf("Generate() returned error: %v", err)
+	}
+	if len(pw) != 20 {
+		t.Fatalf("Generate() = %q, want length 20", pw)
+	}
+	if got := countAny(pw, digitAlphabet); got < 4 {
+		t.Fatalf("Generate() produced %d digits, want at least 4", got)
+	}
+	if got := countAny(pw, symbolAlphabet); got < 3 {
+		t.Fatalf("Generate() produced %d symbols, want at least 3", got)
+	}
+}
+
+func TestGenerateNoUpper(t *testing.T) {
+	pw, err := Generate(30, 0, 0, false, true)
+	if err != nil {
+		t.Fatalf("Generate() returned error: %v", err)
+	}
+	if countAny(pw, upperAlphabet) != 0 {
+		t.Fatalf("Generate(allowUpper=false) = %q, contains uppercase", pw)
+	}
+}
+
+func TestGenerateNoRepeat(t *testing.T) {
+	pw, err := Generate(10, 2, 2, true, false)
+	if err != nil {
+		t.Fatalf("Generate() returned error: %v", err)
+	}
+	seen := make(map[rune]bool)
+	for _, r := range pw {
+		if seen[r] {
+			t.Fatalf("Generate(allowRepeat=false) = %q, contains repeated character %q", pw, r)
+		}
+		seen[r] = true
+	}
+}
+
+func TestGenerateInvalidCounts(t *testing.T) {
+	if _, err := Generate(4, 3, 3, true, true); err == nil {
+		t.Fatal("Generate() should reject numDigits+numSymbols exceeding length")
+	}
+}
+
+func TestGenerateNoRepeatExceedsPool(t *testing.T) {
+	// 30 unique letters requested from a 26-character lowercase pool with
+	// allowRepeat=false must fail fast instead of spinning forever looking
+	// for characters that don't exist.
+	if _, err := Generate(30, 0, 0, false, false); err == nil {
+		t.Fatal("Generate() should reject a class count that exceeds its pool size when allowRepeat is false")
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	got := Entropy("aaaaaaaa", lowerAlphabet)
+	want := 8 * 4.700439718141092 // 8 * log2(26)
+	if got < want-0.001 || got > want+0.001 {
+		t.Fatalf("Entropy() = %v, want %v", got, want)
+	}
+}
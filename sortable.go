@@ -0,0 +1,78 @@
+package nanoid
+
+import (
+	"fmt"
+	"time"
+)
+
+// sortableTimeChars is the number of characters used to encode the
+// millisecond timestamp prefix of a sortable ID. 8 characters at 6 bits
+// each hold 48 bits, enough for Unix milliseconds until the year 10889.
+const sortableTimeChars = 8
+
+// SortableSize is the total length of an ID produced by NewSortable: an
+// 8-character timestamp prefix followed by 13 characters of randomness.
+const SortableSize = sortableTimeChars + 13
+
+// sortableAlphabet is the 64-character set used to encode the timestamp
+// prefix of a sortable ID. Unlike DefaultAlphabet, its characters are in
+// strict ASCII-ascending order, so that the index of a character matches
+// its position in a byte-wise comparison; DefaultAlphabet can't be reused
+// here because '_' (0x5F) sorts above '0'-'9' and 'A'-'Z' (0x30-0x5A),
+// which would break the ordering NewSortable promises.
+const sortableAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz{|"
+
+// sortableAlphabetIndex maps each sortableAlphabet character to its 6-bit
+// value, used to decode the timestamp prefix of a sortable ID.
+var sortableAlphabetIndex = func() map[byte]uint64 {
+	m := make(map[byte]uint64, len(sortableAlphabet))
+	for i := 0; i < len(sortableAlphabet); i++ {
+		m[sortableAlphabet[i]] = uint64(i)
+	}
+	return m
+}()
+
+// NewSortable generates a 21-character ID whose first 8 characters are a
+// millisecond-precision timestamp encoded in sortableAlphabet, followed by
+// 13 characters of nanoid randomness. Because the timestamp is encoded
+// most-significant-character-first using an ASCII-ascending alphabet,
+// byte-wise (and so lexicographic) comparison of two sortable IDs matches
+// the order in which they were generated, making them suitable as database
+// primary keys without adopting a separate ULID/KSUID dependency.
+func NewSortable() (string, error) {
+	suffix, err := GenerateString(DefaultAlphabet, SortableSize-sortableTimeChars)
+	if err != nil {
+		return "", err
+	}
+	return formatSortableTime(time.Now()) + suffix, nil
+}
+
+// formatSortableTime encodes t's Unix milliseconds as sortableTimeChars
+// characters of sortableAlphabet, most significant first.
+func formatSortableTime(t time.Time) string {
+	ms := uint64(t.UnixMilli())
+	buf := make([]byte, sortableTimeChars)
+	for i := sortableTimeChars - 1; i >= 0; i-- {
+		buf[i] = sortableAlphabet[ms&0x3f]
+		ms >>= 6
+	}
+	return string(buf)
+}
+
+// ParseSortableTime recovers the timestamp encoded in the prefix of an ID
+// produced by NewSortable.
+func ParseSortableTime(id string) (time.Time, error) {
+	if len(id) < sortableTimeChars {
+		return time.Time{}, fmt.Errorf("nanoid: sortable id %q is shorter than %d characters", id, sortableTimeChars)
+	}
+
+	var ms uint64
+	for i := 0; i < sortableTimeChars; i++ {
+		v, ok := sortableAlphabetIndex[id[i]]
+		if !ok {
+			return time.Time{}, fmt.Errorf("nanoid: %q is not a valid sortable timestamp character", id[i])
+		}
+		ms = ms<<6 | v
+	}
+	return time.UnixMilli(int64(ms)), nil
+}
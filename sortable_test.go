@@ -0,0 +1,85 @@
+package nanoid
+
+import (
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestNewSortable(t *testing.T) {
+	id, err := NewSortable()
+	if err != nil {
+		t.Fatalf("NewSortable() returned error: %v", err)
+	}
+	if len(id) != SortableSize {
+		t.Fatalf("NewSortable() = %q, want length %d", id, SortableSize)
+	}
+}
+
+func TestSortableRoundTripsAndOrders(t *testing.T) {
+	before := time.Now()
+	a, err := NewSortable()
+	if err != nil {
+		t.Fatalf("NewSortable() returned error: %v", err)
+	}
+
+	time.Sleep(2 * time.Millisecond)
+
+	b, err := NewSortable()
+	if err != nil {
+		t.Fatalf("NewSortable() returned error: %v", err)
+	}
+	after := time.Now()
+
+	if a >= b {
+		t.Fatalf("expected %q < %q for IDs generated in sequence", a, b)
+	}
+
+	ts, err := ParseSortableTime(a)
+	if err != nil {
+		t.Fatalf("ParseSortableTime(%q) returned error: %v", a, err)
+	}
+	if ts.Before(before.Truncate(time.Millisecond)) || ts.After(after) {
+		t.Fatalf("ParseSortableTime(%q) = %v, want between %v and %v", a, ts, before, after)
+	}
+}
+
+func TestParseSortableTimeTooShort(t *testing.T) {
+	if _, err := ParseSortableTime("short"); err == nil {
+		t.Fatal("ParseSortableTime() should reject ids shorter than the timestamp prefix")
+	}
+}
+
+// TestFormatSortableTimeMonotonic checks ordering against synthetic
+// timestamps rather than real-time jitter, so it reliably catches an
+// alphabet whose character order doesn't match byte value order.
+func TestFormatSortableTimeMonotonic(t *testing.T) {
+	var prevMs int64
+	var prev string
+	for i, ms := range sortableMonotonicSamples() {
+		got := formatSortableTime(time.UnixMilli(ms))
+		if i > 0 && got <= prev {
+			t.Fatalf("formatSortableTime(%d) = %q, want > formatSortableTime(%d) = %q", ms, got, prevMs, prev)
+		}
+		prevMs, prev = ms, got
+	}
+}
+
+// sortableMonotonicSamples returns strictly increasing millisecond values
+// that cross every 6-bit digit boundary of the 8-character timestamp
+// prefix, plus a run of consecutive values, so monotonicity is checked
+// both at digit boundaries and for ordinary sequential ticks.
+func sortableMonotonicSamples() []int64 {
+	var samples []int64
+	for digit := 0; digit < sortableTimeChars; digit++ {
+		boundary := int64(1) << uint(6*digit)
+		samples = append(samples, boundary-1, boundary)
+	}
+	base := int64(1700000000000) // an arbitrary real-world Unix ms timestamp
+	for i := int64(0); i < 1000; i++ {
+		samples = append(samples, base+i)
+	}
+
+	sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+	return samples
+}
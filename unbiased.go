@@ -0,0 +1,37 @@
+package nanoid
+
+import "encoding/binary"
+
+// isPowerOfTwo reports whether n is a power of two.
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
+
+// FormatStringUnbiased generates a random string based on generateRandomBuffer,
+// alphabet and size using Lemire's unbiased bounded-modulo method, rather
+// than the power-of-two mask rejection FormatString uses. For each 4-byte
+// chunk of entropy v, it computes prod := uint64(v) * uint64(n) (n being
+// len(alphabet)); the high 32 bits of prod give the index into alphabet,
+// and a chunk is rejected and redrawn only when it would bias the result.
+// This avoids the wasted entropy and extra redraws FormatString incurs on
+// alphabets whose length isn't a power of two.
+func FormatStringUnbiased(generateRandomBuffer BytesGenerator, alphabet string, size int) (string, error) {
+	n := uint32(len(alphabet))
+	threshold := -n % n
+
+	id := make([]byte, 0, size)
+	for len(id) < size {
+		buf, err := generateRandomBuffer(4)
+		if err != nil {
+			return "", err
+		}
+
+		v := binary.BigEndian.Uint32(buf)
+		prod := uint64(v) * uint64(n)
+		if uint32(prod) < threshold {
+			continue
+		}
+		id = append(id, alphabet[uint32(prod>>32)])
+	}
+	return string(id), nil
+}
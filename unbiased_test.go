@@ -0,0 +1,70 @@
+package nanoid
+
+import "testing"
+
+func TestFormatStringUnbiasedLength(t *testing.T) {
+	alphabet := AlphaOnly // 52 characters, not a power of two
+	id, err := FormatStringUnbiased(generateRandomBuffer, alphabet, 100)
+	if err != nil {
+		t.Fatalf("FormatStringUnbiased() returned error: %v", err)
+	}
+	if len(id) != 100 {
+		t.Fatalf("FormatStringUnbiased() = %q, want length 100", id)
+	}
+	for _, r := range id {
+		if !contains(alphabet, byte(r)) {
+			t.Fatalf("FormatStringUnbiased() produced %q, not in alphabet %q", r, alphabet)
+		}
+	}
+}
+
+func TestFormatStringUnbiasedUniformity(t *testing.T) {
+	alphabet := AlphaOnly
+	const samples = 200000
+
+	id, err := FormatStringUnbiased(generateRandomBuffer, alphabet, samples)
+	if err != nil {
+		t.Fatalf("FormatStringUnbiased() returned error: %v", err)
+	}
+
+	counts := make(map[byte]int, len(alphabet))
+	for i := 0; i < len(id); i++ {
+		counts[id[i]]++
+	}
+
+	expected := float64(samples) / float64(len(alphabet))
+	var chiSquared float64
+	for i := 0; i < len(alphabet); i++ {
+		observed := float64(counts[alphabet[i]])
+		diff := observed - expected
+		chiSquared += diff * diff / expected
+	}
+
+	// 51 degrees of freedom (len(alphabet)-1); the critical value at
+	// p=0.001 is ~88.0, well above what a uniform sample should produce.
+	const criticalValue = 100.0
+	if chiSquared > criticalValue {
+		t.Fatalf("chi-squared statistic %.2f exceeds %.2f, distribution looks biased", chiSquared, criticalValue)
+	}
+}
+
+func TestGenerateStringSelectsUnbiasedForNonPowerOfTwo(t *testing.T) {
+	// AlphaOnly has 52 characters, not a power of two; GenerateString
+	// should still produce valid IDs via the unbiased path.
+	id, err := GenerateString(AlphaOnly, 32)
+	if err != nil {
+		t.Fatalf("GenerateString() returned error: %v", err)
+	}
+	if len(id) != 32 {
+		t.Fatalf("GenerateString() = %q, want length 32", id)
+	}
+}
+
+func contains(alphabet string, c byte) bool {
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] == c {
+			return true
+		}
+	}
+	return false
+}
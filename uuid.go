@@ -0,0 +1,77 @@
+package nanoid
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// FormatAsUUID renders 16 bytes of entropy in the canonical
+// xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx hex form.
+func FormatAsUUID(entropy []byte) (string, error) {
+	if len(entropy) != 16 {
+		return "", fmt.Errorf("nanoid: entropy has length %d, want 16", len(entropy))
+	}
+
+	buf := make([]byte, 36)
+	hex.Encode(buf[0:8], entropy[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], entropy[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], entropy[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], entropy[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:36], entropy[10:16])
+	return string(buf), nil
+}
+
+// NewUUID generates 16 bytes of crypto-random entropy and renders it as a
+// canonical UUID string, for projects that want to standardize on this
+// module instead of pulling in a separate UUID dependency.
+func NewUUID() (string, error) {
+	entropy := make([]byte, 16)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+	return FormatAsUUID(entropy)
+}
+
+// ParseUUID parses a canonical UUID string back into its 16 bytes of
+// entropy.
+func ParseUUID(s string) ([]byte, error) {
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return nil, fmt.Errorf("nanoid: %q is not a valid UUID", s)
+	}
+
+	entropy, err := hex.DecodeString(s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:36])
+	if err != nil {
+		return nil, fmt.Errorf("nanoid: %q is not a valid UUID: %w", s, err)
+	}
+	return entropy, nil
+}
+
+// NewUUIDv7 generates an RFC 9562 version 7 (time-ordered) UUID. The first
+// 48 bits are the current Unix millisecond timestamp, followed by the
+// 4-bit version (0x7), 12 random bits, the 2-bit variant (10), and 62 more
+// random bits.
+func NewUUIDv7() (string, error) {
+	entropy := make([]byte, 16)
+	if _, err := rand.Read(entropy); err != nil {
+		return "", err
+	}
+
+	ms := uint64(time.Now().UnixMilli())
+	entropy[0] = byte(ms >> 40)
+	entropy[1] = byte(ms >> 32)
+	entropy[2] = byte(ms >> 24)
+	entropy[3] = byte(ms >> 16)
+	entropy[4] = byte(ms >> 8)
+	entropy[5] = byte(ms)
+
+	entropy[6] = 0x70 | (entropy[6] & 0x0f) // version 7
+	entropy[8] = 0x80 | (entropy[8] & 0x3f) // variant 10
+
+	return FormatAsUUID(entropy)
+}
@@ -0,0 +1,80 @@
+package nanoid
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestFormatAsUUIDAndParseUUID(t *testing.T) {
+	entropy := make([]byte, 16)
+	for i := range entropy {
+		entropy[i] = byte(i)
+	}
+
+	id, err := FormatAsUUID(entropy)
+	if err != nil {
+		t.Fatalf("FormatAsUUID() returned error: %v", err)
+	}
+	if want := "00010203-0405-0607-0809-0a0b0c0d0e0f"; id != want {
+		t.Fatalf("FormatAsUUID() = %q, want %q", id, want)
+	}
+
+	parsed, err := ParseUUID(id)
+	if err != nil {
+		t.Fatalf("ParseUUID(%q) returned error: %v", id, err)
+	}
+	if !bytes.Equal(parsed, entropy) {
+		t.Fatalf("ParseUUID(%q) = %x, want %x", id, parsed, entropy)
+	}
+}
+
+func TestFormatAsUUIDWrongLength(t *testing.T) {
+	if _, err := FormatAsUUID(make([]byte, 15)); err == nil {
+		t.Fatal("FormatAsUUID() should reject entropy that isn't 16 bytes")
+	}
+}
+
+func TestParseUUIDInvalid(t *testing.T) {
+	if _, err := ParseUUID("not-a-uuid"); err == nil {
+		t.Fatal("ParseUUID() should reject malformed input")
+	}
+}
+
+func TestNewUUID(t *testing.T) {
+	id, err := NewUUID()
+	if err != nil {
+		t.Fatalf("NewUUID() returned error: %v", err)
+	}
+	if _, err := ParseUUID(id); err != nil {
+		t.Fatalf("ParseUUID(%q) returned error: %v", id, err)
+	}
+}
+
+func TestNewUUIDv7(t *testing.T) {
+	before := time.Now()
+	id, err := NewUUIDv7()
+	if err != nil {
+		t.Fatalf("NewUUIDv7() returned error: %v", err)
+	}
+	after := time.Now()
+
+	entropy, err := ParseUUID(id)
+	if err != nil {
+		t.Fatalf("ParseUUID(%q) returned error: %v", id, err)
+	}
+
+	if got := entropy[6] >> 4; got != 0x7 {
+		t.Fatalf("NewUUIDv7() version nibble = %x, want 7", got)
+	}
+	if got := entropy[8] >> 6; got != 0x2 {
+		t.Fatalf("NewUUIDv7() variant bits = %b, want 10", got)
+	}
+
+	ms := uint64(entropy[0])<<40 | uint64(entropy[1])<<32 | uint64(entropy[2])<<24 |
+		uint64(entropy[3])<<16 | uint64(entropy[4])<<8 | uint64(entropy[5])
+	ts := time.UnixMilli(int64(ms))
+	if ts.Before(before.Add(-time.Millisecond)) || ts.After(after) {
+		t.Fatalf("NewUUIDv7() timestamp = %v, want between %v and %v", ts, before, after)
+	}
+}